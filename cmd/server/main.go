@@ -0,0 +1,115 @@
+// Command server starts the users API HTTP server.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/audit"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/auth"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/config"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/handlers"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/health"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/logger"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/metrics"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/middleware"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/store"
+)
+
+func main() {
+	cfg := config.Load()
+
+	s, err := store.New(cfg.Storage, cfg.DSN)
+	if err != nil {
+		log.Fatalf("failed to initialize %s storage: %v", cfg.Storage, err)
+	}
+	defer s.Close()
+
+	authManager := auth.NewManager(cfg.JWTSecret, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
+	requestLogger := logger.New(cfg.LogLevel)
+
+	auditLogger, err := newAuditLogger(cfg)
+	if err != nil {
+		log.Fatalf("failed to initialize audit log: %v", err)
+	}
+	if closer, ok := auditLogger.(*audit.FileLogger); ok {
+		defer closer.Close()
+	}
+
+	metrics.RegisterUsersTotal(s.GetUsersCount)
+
+	router := mux.NewRouter()
+	router.Use(middleware.Recovery, middleware.RequestID, middleware.Logging(requestLogger), middleware.CORS, metrics.Middleware)
+	handlers.New(s, authManager, auditLogger).RegisterRoutes(router)
+
+	router.HandleFunc("/healthz", health.Liveness).Methods("GET")
+	router.HandleFunc("/readyz", health.Readiness(s)).Methods("GET")
+	router.Handle("/metrics", metrics.Handler()).Methods("GET")
+
+	fmt.Printf("Server starting on port %s (storage=%s)\n", cfg.Port, cfg.Storage)
+	fmt.Println("\nAPI Endpoints:")
+	fmt.Println("POST   /api/v1/users        - Create a new user")
+	fmt.Println("GET    /api/v1/users        - Get all users")
+	fmt.Println("GET    /api/v1/users/count  - Get users count")
+	fmt.Println("GET    /api/v1/users/{id}   - Get user by ID")
+	fmt.Println("PUT    /api/v1/users/{id}   - Update user by ID")
+	fmt.Println("DELETE /api/v1/users/{id}   - Delete user by ID")
+	fmt.Println("DELETE /api/v1/users/clear  - Clear all users")
+	fmt.Println("GET    /api/v1/openapi.json - OpenAPI 3 spec (JSON)")
+	fmt.Println("GET    /api/v1/openapi.yaml - OpenAPI 3 spec (YAML)")
+	fmt.Println("GET    /api/v1/docs         - Swagger UI")
+	fmt.Println("GET    /healthz             - Liveness probe")
+	fmt.Println("GET    /readyz              - Readiness probe")
+	fmt.Println("GET    /metrics             - Prometheus metrics")
+
+	run(router, cfg.Port, cfg.ShutdownTimeout)
+}
+
+// run starts server on addr and blocks until SIGINT or SIGTERM, then drains
+// in-flight requests for up to shutdownTimeout before returning.
+func run(handler http.Handler, addr string, shutdownTimeout time.Duration) {
+	server := &http.Server{Addr: addr, Handler: handler}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("server error: %v", err)
+		}
+	case <-ctx.Done():
+		stop()
+		log.Println("shutting down...")
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Fatalf("graceful shutdown failed: %v", err)
+		}
+	}
+}
+
+// newAuditLogger builds the audit sink selected by cfg: a rotating file if
+// AuditLogPath is set, stdout otherwise.
+func newAuditLogger(cfg config.Config) (audit.Logger, error) {
+	if cfg.AuditLogPath == "" {
+		return audit.NewStdoutLogger(os.Stdout), nil
+	}
+	return audit.NewFileLogger(cfg.AuditLogPath, cfg.AuditMaxBytes)
+}
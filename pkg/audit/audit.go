@@ -0,0 +1,22 @@
+// Package audit records administrative actions taken against user data.
+// It is deliberately separate from pkg/logger's request logs: audit
+// entries are a compliance trail (who did what to which user), not an
+// operational one.
+package audit
+
+import "time"
+
+// Entry describes a single audited action.
+type Entry struct {
+	Time     time.Time      `json:"time"`
+	Actor    int            `json:"actor"` // acting user's ID, 0 if unauthenticated
+	Action   string         `json:"action"`
+	TargetID int            `json:"target_id"`
+	Args     map[string]any `json:"args,omitempty"`
+}
+
+// Logger persists audit entries. Implementations must be safe for
+// concurrent use.
+type Logger interface {
+	Log(e Entry) error
+}
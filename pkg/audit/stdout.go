@@ -0,0 +1,26 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// StdoutLogger writes each entry as a single JSON line to w. It's the
+// default audit sink: good enough for local development and for
+// deployments that ship stdout to a log aggregator.
+type StdoutLogger struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewStdoutLogger returns a StdoutLogger writing to w.
+func NewStdoutLogger(w io.Writer) *StdoutLogger {
+	return &StdoutLogger{w: w}
+}
+
+func (l *StdoutLogger) Log(e Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return json.NewEncoder(l.w).Encode(e)
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+)
+
+// TokenType distinguishes an access token from a refresh token so one can't
+// be used in place of the other.
+type TokenType string
+
+const (
+	AccessToken  TokenType = "access"
+	RefreshToken TokenType = "refresh"
+)
+
+// ErrWrongTokenType is returned by Parse when a token of the wrong type is
+// presented (e.g. a refresh token used as a bearer token).
+var ErrWrongTokenType = errors.New("wrong token type")
+
+// Claims are the JWT claims issued by Manager.
+type Claims struct {
+	Role      models.Role `json:"role"`
+	Type      TokenType   `json:"type"`
+	XSRFToken string      `json:"xsrf_token,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// UserID parses the subject claim back into the numeric user ID.
+func (c Claims) UserID() (int, error) {
+	return strconv.Atoi(c.Subject)
+}
+
+// Manager issues and validates HS256 JWTs.
+type Manager struct {
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewManager builds a Manager signing tokens with secret. accessTTL and
+// refreshTTL configure how long access and refresh tokens remain valid.
+func NewManager(secret string, accessTTL, refreshTTL time.Duration) *Manager {
+	return &Manager{secret: []byte(secret), accessTTL: accessTTL, refreshTTL: refreshTTL}
+}
+
+// IssueAccessToken returns a signed access token for userID/role. If
+// xsrfToken is non-empty it's embedded in the claims for the cookie-based
+// session flow.
+func (m *Manager) IssueAccessToken(userID int, role models.Role, xsrfToken string) (string, time.Time, error) {
+	return m.issue(userID, role, AccessToken, xsrfToken, m.accessTTL)
+}
+
+// IssueRefreshToken returns a signed refresh token for userID/role.
+func (m *Manager) IssueRefreshToken(userID int, role models.Role) (string, time.Time, error) {
+	return m.issue(userID, role, RefreshToken, "", m.refreshTTL)
+}
+
+func (m *Manager) issue(userID int, role models.Role, typ TokenType, xsrfToken string, ttl time.Duration) (string, time.Time, error) {
+	expiresAt := time.Now().Add(ttl)
+	claims := Claims{
+		Role:      role,
+		Type:      typ,
+		XSRFToken: xsrfToken,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.Itoa(userID),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	return signed, expiresAt, err
+}
+
+// Parse validates tokenString and returns its claims if it's a valid,
+// unexpired access token.
+func (m *Manager) Parse(tokenString string) (*Claims, error) {
+	return m.parse(tokenString, AccessToken)
+}
+
+// ParseRefreshToken validates tokenString and returns its claims if it's a
+// valid, unexpired refresh token.
+func (m *Manager) ParseRefreshToken(tokenString string) (*Claims, error) {
+	return m.parse(tokenString, RefreshToken)
+}
+
+func (m *Manager) parse(tokenString string, want TokenType) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return m.secret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenSignatureInvalid
+	}
+	if claims.Type != want {
+		return nil, ErrWrongTokenType
+	}
+
+	return claims, nil
+}
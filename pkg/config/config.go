@@ -0,0 +1,100 @@
+// Package config loads server configuration from the environment.
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds the environment-driven settings needed to start the server.
+type Config struct {
+	// Port the HTTP server listens on, e.g. ":8080".
+	Port string
+	// Storage selects the UserStore backend: memory, sqlite, or postgres.
+	Storage string
+	// DSN is the data source name for the sqlite (file path) or postgres
+	// (connection string) backends. Unused for memory.
+	DSN string
+
+	// JWTSecret signs and verifies access/refresh tokens.
+	JWTSecret string
+	// AccessTokenTTL controls how long an access token stays valid.
+	AccessTokenTTL time.Duration
+	// RefreshTokenTTL controls how long a refresh token stays valid.
+	RefreshTokenTTL time.Duration
+
+	// LogLevel controls the request logger's verbosity: debug, info, warn,
+	// or error.
+	LogLevel string
+	// AuditLogPath is the file audit entries are appended to. Empty means
+	// write them to stdout instead.
+	AuditLogPath string
+	// AuditMaxBytes rotates the audit log once it grows past this size.
+	// Zero disables rotation.
+	AuditMaxBytes int64
+
+	// ShutdownTimeout bounds how long the server waits for in-flight
+	// requests to finish when draining on SIGINT/SIGTERM.
+	ShutdownTimeout time.Duration
+}
+
+// Load reads configuration from the environment, applying defaults for any
+// variable that isn't set.
+//
+//	PORT              - listen address, default ":8080"
+//	STORAGE           - memory, sqlite, or postgres, default "memory"
+//	DSN               - data source name for sqlite/postgres
+//	JWT_SECRET        - HMAC signing secret, default "dev-secret" (set a real
+//	                    secret in production)
+//	ACCESS_TOKEN_TTL  - access token lifetime, default "15m"
+//	REFRESH_TOKEN_TTL - refresh token lifetime, default "168h" (7 days)
+//	LOG_LEVEL         - request logger level, default "info"
+//	AUDIT_LOG_PATH    - file to append audit entries to, default "" (stdout)
+//	AUDIT_MAX_BYTES   - rotate the audit log past this size, default 10MiB
+//	SHUTDOWN_TIMEOUT  - time to drain in-flight requests on shutdown, default "15s"
+func Load() Config {
+	return Config{
+		Port:            getEnv("PORT", ":8080"),
+		Storage:         getEnv("STORAGE", "memory"),
+		DSN:             getEnv("DSN", ""),
+		JWTSecret:       getEnv("JWT_SECRET", "dev-secret"),
+		AccessTokenTTL:  getDurationEnv("ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL: getDurationEnv("REFRESH_TOKEN_TTL", 7*24*time.Hour),
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		AuditLogPath:    getEnv("AUDIT_LOG_PATH", ""),
+		AuditMaxBytes:   getInt64Env("AUDIT_MAX_BYTES", 10*1024*1024),
+		ShutdownTimeout: getDurationEnv("SHUTDOWN_TIMEOUT", 15*time.Second),
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getDurationEnv(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+func getInt64Env(key string, fallback int64) int64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/auth"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/response"
+)
+
+// credentialsRequest is the payload for register and login.
+type credentialsRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Age      int    `json:"age"`
+	Password string `json:"password"`
+}
+
+// tokenResponse is returned by register, login, and refresh.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	XSRFToken    string `json:"xsrf_token,omitempty"`
+}
+
+// isCookieFlow reports whether the caller asked for the cookie-based
+// session flow instead of the default bearer-token flow.
+func isCookieFlow(r *http.Request) bool {
+	return r.URL.Query().Get("flow") == "cookie"
+}
+
+// Register creates a new user with the "user" role.
+func (h *Handlers) Register(w http.ResponseWriter, r *http.Request) {
+	var req credentialsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	user := models.User{Name: req.Name, Email: req.Email, Age: req.Age, Role: models.RoleUser}
+	if err := user.Validate(); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Password == "" {
+		response.Error(w, http.StatusBadRequest, "Password is required")
+		return
+	}
+
+	hash, err := auth.HashPassword(req.Password)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	user.PasswordHash = hash
+
+	created, err := h.Store.CreateUser(user)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	h.issueTokens(w, r, created)
+}
+
+// Login authenticates an email/password pair and issues tokens.
+func (h *Handlers) Login(w http.ResponseWriter, r *http.Request) {
+	var req credentialsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	user, err := h.Store.GetUserByEmail(req.Email)
+	if err != nil {
+		response.Error(w, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	if err := auth.CheckPassword(user.PasswordHash, req.Password); err != nil {
+		response.Error(w, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	h.issueTokens(w, r, user)
+}
+
+// refreshRequest is the payload for the refresh endpoint.
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Refresh exchanges a valid refresh token for a new access token.
+func (h *Handlers) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req refreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	claims, err := h.AuthManager.ParseRefreshToken(req.RefreshToken)
+	if err != nil {
+		response.Error(w, http.StatusUnauthorized, "Invalid or expired refresh token")
+		return
+	}
+
+	userID, err := claims.UserID()
+	if err != nil {
+		response.Error(w, http.StatusUnauthorized, "Invalid or expired refresh token")
+		return
+	}
+
+	user, err := h.Store.GetUser(userID)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	accessToken, expiresAt, err := h.AuthManager.IssueAccessToken(user.ID, user.Role, "")
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, tokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(time.Until(expiresAt).Seconds()),
+	})
+}
+
+// issueTokens issues an access/refresh token pair for user. In the default
+// flow both tokens are returned in the JSON body. In the cookie flow
+// (?flow=cookie) the access token is additionally set as an HttpOnly
+// cookie, and the response carries the XSRF token the client must echo back
+// via the X-XSRF-Token header on state-changing requests.
+func (h *Handlers) issueTokens(w http.ResponseWriter, r *http.Request, user models.User) {
+	xsrfToken := ""
+	if isCookieFlow(r) {
+		token, err := auth.GenerateXSRFToken()
+		if err != nil {
+			response.Error(w, http.StatusInternalServerError, "Internal server error")
+			return
+		}
+		xsrfToken = token
+	}
+
+	accessToken, expiresAt, err := h.AuthManager.IssueAccessToken(user.ID, user.Role, xsrfToken)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	refreshToken, _, err := h.AuthManager.IssueRefreshToken(user.ID, user.Role)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	if xsrfToken != "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:     "access_token",
+			Value:    accessToken,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+			Path:     "/",
+			Expires:  expiresAt,
+		})
+	}
+
+	response.JSON(w, http.StatusCreated, tokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(time.Until(expiresAt).Seconds()),
+		XSRFToken:    xsrfToken,
+	})
+}
@@ -0,0 +1,294 @@
+// Package handlers implements the HTTP handlers for the users API.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/audit"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/auth"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/middleware"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/openapi"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/response"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/store"
+)
+
+// Handlers holds the dependencies shared by the users API handlers.
+type Handlers struct {
+	Store       store.UserStore
+	AuthManager *auth.Manager
+	Audit       audit.Logger
+}
+
+// New builds Handlers backed by s, authenticating callers via authManager.
+// auditLogger may be nil, in which case mutating actions go unaudited.
+func New(s store.UserStore, authManager *auth.Manager, auditLogger audit.Logger) *Handlers {
+	return &Handlers{Store: s, AuthManager: authManager, Audit: auditLogger}
+}
+
+// logAudit records a mutating action against targetID, attributing it to
+// the authenticated caller in r. It's best-effort: a failure to persist the
+// entry is logged but never blocks the response.
+func (h *Handlers) logAudit(r *http.Request, action string, targetID int, args map[string]any) {
+	if h.Audit == nil {
+		return
+	}
+
+	actorID, _ := middleware.UserIDFromContext(r.Context())
+	entry := audit.Entry{Time: time.Now(), Actor: actorID, Action: action, TargetID: targetID, Args: args}
+	if err := h.Audit.Log(entry); err != nil {
+		log.Printf("audit log: %v", err)
+	}
+}
+
+// RegisterRoutes mounts the users API under /api/v1 on router. Auth routes
+// are public; everything else requires a valid token, and a few admin
+// actions additionally require the admin role.
+func (h *Handlers) RegisterRoutes(router *mux.Router) {
+	api := router.PathPrefix("/api/v1").Subrouter()
+	api.Use(openapi.ValidateRequest)
+
+	api.HandleFunc("/openapi.json", openapi.JSONSpec).Methods("GET")
+	api.HandleFunc("/openapi.yaml", openapi.YAMLSpec).Methods("GET")
+	api.PathPrefix("/docs").Handler(openapi.Docs).Methods("GET")
+
+	api.HandleFunc("/auth/register", h.Register).Methods("POST")
+	api.HandleFunc("/auth/login", h.Login).Methods("POST")
+	api.HandleFunc("/auth/refresh", h.Refresh).Methods("POST")
+
+	authed := api.PathPrefix("").Subrouter()
+	authed.Use(middleware.RequireAuth(h.AuthManager))
+
+	authed.Handle("/users", middleware.RequireAdmin(http.HandlerFunc(h.CreateUser))).Methods("POST")
+	authed.Handle("/users", middleware.RequireAdmin(http.HandlerFunc(h.GetUsers))).Methods("GET")
+	authed.HandleFunc("/users/count", h.GetUsersCount).Methods("GET")
+	authed.Handle("/users/clear", middleware.RequireAdmin(http.HandlerFunc(h.ClearUsers))).Methods("DELETE")
+	authed.HandleFunc("/users/{id}", h.GetUser).Methods("GET")
+	authed.HandleFunc("/users/{id}", h.UpdateUser).Methods("PUT")
+	authed.Handle("/users/{id}", middleware.RequireAdmin(http.HandlerFunc(h.DeleteUser))).Methods("DELETE")
+}
+
+// userInput is the JSON body accepted by the admin CreateUser/UpdateUser
+// endpoints. Unlike models.User, it carries a plaintext password (hashed
+// before being stored) instead of a PasswordHash.
+type userInput struct {
+	Name     string      `json:"name"`
+	Email    string      `json:"email"`
+	Age      int         `json:"age"`
+	Password string      `json:"password"`
+	Role     models.Role `json:"role"`
+}
+
+// CREATE - Add a new user (admin only; regular users self-register via
+// /auth/register)
+func (h *Handlers) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var input userInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	user := models.User{Name: input.Name, Email: input.Email, Age: input.Age, Role: input.Role}
+	if err := user.Validate(); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Password != "" {
+		hash, err := auth.HashPassword(input.Password)
+		if err != nil {
+			response.Error(w, http.StatusInternalServerError, "Internal server error")
+			return
+		}
+		user.PasswordHash = hash
+	}
+
+	created, err := h.Store.CreateUser(user)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	h.logAudit(r, "CreateUser", created.ID, map[string]any{"email": created.Email, "role": string(created.Role)})
+	response.JSON(w, http.StatusCreated, created)
+}
+
+// READ - Get all users, paginated and optionally filtered/sorted
+func (h *Handlers) GetUsers(w http.ResponseWriter, r *http.Request) {
+	query, err := parseUserQuery(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := query.Normalize(); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.Store.GetUsers(query)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	totalPages := (page.Total + query.PerPage - 1) / query.PerPage
+	if link := buildLinkHeader(r, query, totalPages); link != "" {
+		w.Header().Set("Link", link)
+	}
+
+	response.JSON(w, http.StatusOK, usersEnvelope{
+		Data:       page.Users,
+		Page:       query.Page,
+		PerPage:    query.PerPage,
+		Total:      page.Total,
+		TotalPages: totalPages,
+	})
+}
+
+// READ - Get user by ID (self or admin)
+func (h *Handlers) GetUser(w http.ResponseWriter, r *http.Request) {
+	id, err := idFromPath(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if !h.authorizeSelfOrAdmin(r, id) {
+		response.Error(w, http.StatusForbidden, "You may only access your own record")
+		return
+	}
+
+	user, err := h.Store.GetUser(id)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, user)
+}
+
+// UPDATE - Update user by ID (self or admin; only an admin may change Role)
+func (h *Handlers) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	id, err := idFromPath(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if !h.authorizeSelfOrAdmin(r, id) {
+		response.Error(w, http.StatusForbidden, "You may only update your own record")
+		return
+	}
+
+	var input userInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	updatedUser := models.User{Name: input.Name, Email: input.Email, Age: input.Age}
+	if err := updatedUser.Validate(); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Password != "" {
+		hash, err := auth.HashPassword(input.Password)
+		if err != nil {
+			response.Error(w, http.StatusInternalServerError, "Internal server error")
+			return
+		}
+		updatedUser.PasswordHash = hash
+	}
+
+	if input.Role != "" {
+		if middleware.RoleFromContext(r.Context()) != models.RoleAdmin {
+			response.Error(w, http.StatusForbidden, "Only an admin may change a user's role")
+			return
+		}
+		updatedUser.Role = input.Role
+	}
+
+	user, err := h.Store.UpdateUser(id, updatedUser)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	h.logAudit(r, "UpdateUser", id, map[string]any{"email": updatedUser.Email, "role": string(updatedUser.Role)})
+	response.JSON(w, http.StatusOK, user)
+}
+
+// authorizeSelfOrAdmin reports whether the authenticated caller is an admin
+// or is acting on their own user ID.
+func (h *Handlers) authorizeSelfOrAdmin(r *http.Request, targetID int) bool {
+	if middleware.RoleFromContext(r.Context()) == models.RoleAdmin {
+		return true
+	}
+	callerID, ok := middleware.UserIDFromContext(r.Context())
+	return ok && callerID == targetID
+}
+
+// DELETE - Delete user by ID
+func (h *Handlers) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	id, err := idFromPath(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if err := h.Store.DeleteUser(id); err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	h.logAudit(r, "DeleteUser", id, nil)
+	response.JSON(w, http.StatusOK, map[string]string{"message": "User deleted successfully"})
+}
+
+// GET - Get users count
+func (h *Handlers) GetUsersCount(w http.ResponseWriter, r *http.Request) {
+	count, err := h.Store.GetUsersCount()
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]int{"count": count})
+}
+
+// DELETE - Clear all users (bonus endpoint)
+func (h *Handlers) ClearUsers(w http.ResponseWriter, r *http.Request) {
+	if err := h.Store.ClearUsers(); err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	h.logAudit(r, "ClearUsers", 0, nil)
+	response.JSON(w, http.StatusOK, map[string]string{"message": "All users cleared successfully"})
+}
+
+// idFromPath extracts the {id} path variable as an int.
+func idFromPath(r *http.Request) (int, error) {
+	return strconv.Atoi(mux.Vars(r)["id"])
+}
+
+// writeStoreError translates a store error into the matching HTTP status
+// code.
+func writeStoreError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, store.ErrUserNotFound):
+		response.Error(w, http.StatusNotFound, "User not found")
+	case errors.Is(err, store.ErrEmailConflict):
+		response.Error(w, http.StatusConflict, "Email already exists")
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/auth"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/store"
+)
+
+// postgresTestDSNEnv names the environment variable that points the
+// Postgres-backed tests at a live database. It's intentionally unset in most
+// environments, so those tests skip cleanly rather than failing to connect.
+const postgresTestDSNEnv = "POSTGRES_TEST_DSN"
+
+// testApp bundles a router with the store backing it, so tests can seed or
+// promote users directly without going through HTTP.
+type testApp struct {
+	Router *mux.Router
+	Store  store.UserStore
+}
+
+// newTestApps returns one app per storage backend, so the handler suite
+// below runs identically against memory and SQLite.
+func newTestApps(t *testing.T) map[string]testApp {
+	t.Helper()
+
+	apps := map[string]testApp{
+		"memory": newTestApp(t, store.NewMemoryStore()),
+	}
+
+	sqlitePath := filepath.Join(t.TempDir(), "test.db")
+	sqliteStore, err := store.NewSQLiteStore(sqlitePath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	t.Cleanup(func() { sqliteStore.Close() })
+	apps["sqlite"] = newTestApp(t, sqliteStore)
+
+	if dsn := os.Getenv(postgresTestDSNEnv); dsn != "" {
+		postgresStore, err := store.NewPostgresStore(dsn)
+		if err != nil {
+			t.Fatalf("NewPostgresStore: %v", err)
+		}
+		// The target database is expected to be a scratch instance reused
+		// across runs, so start from a clean table.
+		if err := postgresStore.ClearUsers(); err != nil {
+			t.Fatalf("clear postgres users: %v", err)
+		}
+		t.Cleanup(func() { postgresStore.Close() })
+		apps["postgres"] = newTestApp(t, postgresStore)
+	} else {
+		t.Logf("%s not set; skipping Postgres backend", postgresTestDSNEnv)
+	}
+
+	return apps
+}
+
+func newTestApp(t *testing.T, s store.UserStore) testApp {
+	t.Helper()
+
+	router := mux.NewRouter()
+	New(s, auth.NewManager("test-secret", time.Minute, time.Hour), nil).RegisterRoutes(router)
+	return testApp{Router: router, Store: s}
+}
+
+// adminToken registers a new admin user against app and returns a bearer
+// token for them. There is no HTTP path to self-promote, so the role flip
+// goes through the store directly.
+func adminToken(t *testing.T, app testApp) string {
+	t.Helper()
+
+	const email = "admin@example.com"
+	tokenFor(t, app, "Admin", email, "hunter2")
+
+	user, err := app.Store.GetUserByEmail(email)
+	if err != nil {
+		t.Fatalf("look up %s: %v", email, err)
+	}
+	if _, err := app.Store.UpdateUser(user.ID, models.User{
+		Name: user.Name, Email: user.Email, Age: user.Age, Role: models.RoleAdmin,
+	}); err != nil {
+		t.Fatalf("promote %s to admin: %v", email, err)
+	}
+
+	return login(t, app, email, "hunter2").AccessToken
+}
+
+func tokenFor(t *testing.T, app testApp, name, email, password string) tokenResponse {
+	t.Helper()
+
+	body, _ := json.Marshal(credentialsRequest{Name: name, Email: email, Age: 40, Password: password})
+	rec := doRequest(app.Router, "POST", "/api/v1/auth/register", body)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("register %s: got status %d, body %s", email, rec.Code, rec.Body)
+	}
+	var tokens tokenResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &tokens); err != nil {
+		t.Fatalf("register %s: decode response: %v", email, err)
+	}
+	return tokens
+}
+
+func login(t *testing.T, app testApp, email, password string) tokenResponse {
+	t.Helper()
+
+	body, _ := json.Marshal(credentialsRequest{Email: email, Password: password})
+	rec := doRequest(app.Router, "POST", "/api/v1/auth/login", body)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("login %s: got status %d, body %s", email, rec.Code, rec.Body)
+	}
+	var tokens tokenResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &tokens); err != nil {
+		t.Fatalf("login %s: decode response: %v", email, err)
+	}
+	return tokens
+}
+
+func TestHandlerSuite(t *testing.T) {
+	for name, app := range newTestApps(t) {
+		t.Run(name, func(t *testing.T) {
+			testCRUDLifecycle(t, app.Router, adminToken(t, app))
+		})
+	}
+}
+
+func testCRUDLifecycle(t *testing.T, router *mux.Router, token string) {
+	// Create
+	body, _ := json.Marshal(userInput{Name: "Ada Lovelace", Email: "ada@example.com", Age: 36, Password: "hunter2"})
+	rec := doRequestAuth(router, token, "POST", "/api/v1/users", body)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create: got status %d, body %s", rec.Code, rec.Body)
+	}
+	var created models.User
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("create: decode response: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatalf("create: expected a non-zero ID, got %+v", created)
+	}
+	if created.Role != models.RoleUser {
+		t.Fatalf("create: expected default role %q, got %q", models.RoleUser, created.Role)
+	}
+
+	// Duplicate email is rejected
+	rec = doRequestAuth(router, token, "POST", "/api/v1/users", body)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("duplicate create: got status %d, want %d", rec.Code, http.StatusConflict)
+	}
+
+	// Get by ID
+	rec = doRequestAuth(router, token, "GET", fmt.Sprintf("/api/v1/users/%d", created.ID), nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("get: got status %d, body %s", rec.Code, rec.Body)
+	}
+
+	// Get all
+	rec = doRequestAuth(router, token, "GET", "/api/v1/users", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list: got status %d", rec.Code)
+	}
+	var listResp struct {
+		Data  []models.User `json:"data"`
+		Total int           `json:"total"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &listResp)
+	if listResp.Total != 2 { // the admin plus Ada
+		t.Fatalf("list: got total %d, want 2", listResp.Total)
+	}
+
+	// Count
+	rec = doRequestAuth(router, token, "GET", "/api/v1/users/count", nil)
+	var countResp struct {
+		Count int `json:"count"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &countResp)
+	if countResp.Count != 2 {
+		t.Fatalf("count: got %d, want 2", countResp.Count)
+	}
+
+	// Update
+	updateBody, _ := json.Marshal(userInput{Name: "Ada King", Email: "ada@example.com", Age: 37})
+	rec = doRequestAuth(router, token, "PUT", fmt.Sprintf("/api/v1/users/%d", created.ID), updateBody)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("update: got status %d, body %s", rec.Code, rec.Body)
+	}
+	var updated models.User
+	json.Unmarshal(rec.Body.Bytes(), &updated)
+	if updated.Name != "Ada King" {
+		t.Fatalf("update: got name %q, want %q", updated.Name, "Ada King")
+	}
+
+	// Update on missing ID
+	rec = doRequestAuth(router, token, "PUT", "/api/v1/users/99999", updateBody)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("update missing: got status %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	// Delete
+	rec = doRequestAuth(router, token, "DELETE", fmt.Sprintf("/api/v1/users/%d", created.ID), nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("delete: got status %d", rec.Code)
+	}
+	rec = doRequestAuth(router, token, "GET", fmt.Sprintf("/api/v1/users/%d", created.ID), nil)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("get after delete: got status %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	// Clear
+	doRequestAuth(router, token, "POST", "/api/v1/users", body)
+	rec = doRequestAuth(router, token, "DELETE", "/api/v1/users/clear", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("clear: got status %d", rec.Code)
+	}
+	rec = doRequestAuth(router, token, "GET", "/api/v1/users/count", nil)
+	json.Unmarshal(rec.Body.Bytes(), &countResp)
+	if countResp.Count != 0 {
+		t.Fatalf("count after clear: got %d, want 0", countResp.Count)
+	}
+}
+
+func doRequest(router *mux.Router, method, path string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func doRequestAuth(router *mux.Router, token, method, path string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestOpenAPISpecAndValidation(t *testing.T) {
+	for name, app := range newTestApps(t) {
+		t.Run(name, func(t *testing.T) {
+			rec := doRequest(app.Router, "GET", "/api/v1/openapi.json", nil)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("openapi.json: got status %d", rec.Code)
+			}
+			var doc map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+				t.Fatalf("openapi.json: decode: %v", err)
+			}
+			if doc["openapi"] != "3.0.3" {
+				t.Fatalf("openapi.json: got version %v", doc["openapi"])
+			}
+
+			rec = doRequest(app.Router, "GET", "/api/v1/openapi.yaml", nil)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("openapi.yaml: got status %d", rec.Code)
+			}
+
+			rec = doRequest(app.Router, "GET", "/api/v1/docs", nil)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("docs: got status %d", rec.Code)
+			}
+
+			token := adminToken(t, app)
+
+			badEmail, _ := json.Marshal(userInput{Name: "Bad", Email: "not-an-email", Age: 30})
+			rec = doRequestAuth(app.Router, token, "POST", "/api/v1/users", badEmail)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("malformed email: got status %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+
+			negativeAge, _ := json.Marshal(userInput{Name: "Bad", Email: "bad@example.com", Age: -1})
+			rec = doRequestAuth(app.Router, token, "POST", "/api/v1/users", negativeAge)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("negative age: got status %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
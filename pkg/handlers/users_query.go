@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/store"
+)
+
+// usersEnvelope wraps a page of users with pagination metadata.
+type usersEnvelope struct {
+	Data       []models.User `json:"data"`
+	Page       int           `json:"page"`
+	PerPage    int           `json:"per_page"`
+	Total      int           `json:"total"`
+	TotalPages int           `json:"total_pages"`
+}
+
+// parseUserQuery reads page, per_page, sort, order, name, email, min_age,
+// and max_age from the request's query string.
+func parseUserQuery(r *http.Request) (store.UserQuery, error) {
+	q := r.URL.Query()
+	query := store.UserQuery{
+		Sort:  q.Get("sort"),
+		Order: q.Get("order"),
+		Name:  q.Get("name"),
+		Email: q.Get("email"),
+	}
+
+	var err error
+	if v := q.Get("page"); v != "" {
+		if query.Page, err = strconv.Atoi(v); err != nil {
+			return query, fmt.Errorf("invalid page %q", v)
+		}
+	}
+	if v := q.Get("per_page"); v != "" {
+		if query.PerPage, err = strconv.Atoi(v); err != nil {
+			return query, fmt.Errorf("invalid per_page %q", v)
+		}
+	}
+	if v := q.Get("min_age"); v != "" {
+		if query.MinAge, err = strconv.Atoi(v); err != nil {
+			return query, fmt.Errorf("invalid min_age %q", v)
+		}
+	}
+	if v := q.Get("max_age"); v != "" {
+		if query.MaxAge, err = strconv.Atoi(v); err != nil {
+			return query, fmt.Errorf("invalid max_age %q", v)
+		}
+	}
+
+	return query, nil
+}
+
+// buildLinkHeader renders an RFC 5988 Link header for the given page of
+// query, preserving the request's other query parameters.
+func buildLinkHeader(r *http.Request, query store.UserQuery, totalPages int) string {
+	if totalPages == 0 {
+		return ""
+	}
+
+	pageURL := func(page int) string {
+		q := r.URL.Query()
+		q.Set("page", strconv.Itoa(page))
+		q.Set("per_page", strconv.Itoa(query.PerPage))
+		u := *r.URL
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+
+	var links []string
+	if query.Page > 1 {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, pageURL(query.Page-1)))
+	}
+	if query.Page < totalPages {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, pageURL(query.Page+1)))
+	}
+	links = append(links, fmt.Sprintf(`<%s>; rel="first"`, pageURL(1)))
+	links = append(links, fmt.Sprintf(`<%s>; rel="last"`, pageURL(totalPages)))
+
+	return strings.Join(links, ", ")
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+)
+
+func TestGetUsersPaginationAndFiltering(t *testing.T) {
+	for name, app := range newTestApps(t) {
+		t.Run(name, func(t *testing.T) {
+			token := adminToken(t, app)
+			seedUsers(app.Router, token, []models.User{
+				{Name: "Alice", Email: "alice@example.com", Age: 20},
+				{Name: "Bob", Email: "bob@example.com", Age: 30},
+				{Name: "Carol", Email: "carol@example.com", Age: 40},
+			})
+
+			rec := doRequestAuth(app.Router, token, "GET", "/api/v1/users?per_page=2&page=1&sort=age&order=asc", nil)
+			var page usersEnvelope
+			if err := json.Unmarshal(rec.Body.Bytes(), &page); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if page.Total != 4 || len(page.Data) != 2 { // the admin plus Alice, Bob, Carol
+				t.Fatalf("got page=%+v", page)
+			}
+			if page.Data[0].Name != "Alice" || page.Data[1].Name != "Bob" {
+				t.Fatalf("unexpected sort order: %+v", page.Data)
+			}
+			if link := rec.Header().Get("Link"); link == "" {
+				t.Fatalf("expected a Link header, got none")
+			}
+
+			rec = doRequestAuth(app.Router, token, "GET", "/api/v1/users?min_age=25", nil)
+			json.Unmarshal(rec.Body.Bytes(), &page)
+			if page.Total != 3 { // Bob, Carol, and the admin (age 40)
+				t.Fatalf("min_age filter: got total %d, want 3", page.Total)
+			}
+
+			rec = doRequestAuth(app.Router, token, "GET", "/api/v1/users?sort=nope", nil)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("invalid sort field: got status %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func seedUsers(router *mux.Router, token string, users []models.User) {
+	for _, u := range users {
+		body, _ := json.Marshal(u)
+		doRequestAuth(router, token, "POST", "/api/v1/users", body)
+	}
+}
@@ -0,0 +1,30 @@
+// Package health implements the server's liveness and readiness probes.
+package health
+
+import (
+	"net/http"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/store"
+)
+
+// Liveness reports whether the process itself is up. It never touches the
+// store, so a hung database doesn't take down the liveness check along
+// with it.
+func Liveness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+// Readiness returns a handler reporting whether s can currently serve
+// requests, by pinging it with a cheap read.
+func Readiness(s store.UserStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, err := s.GetUsersCount(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("not ready"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}
+}
@@ -0,0 +1,30 @@
+// Package logger configures the application's structured request logger on
+// top of the standard library's log/slog.
+package logger
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// New returns a JSON-structured slog.Logger writing to stdout. levelName is
+// one of debug, info, warn, or error (case-insensitive); anything else
+// falls back to info.
+func New(levelName string) *slog.Logger {
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: parseLevel(levelName)})
+	return slog.New(handler)
+}
+
+func parseLevel(levelName string) slog.Level {
+	switch strings.ToLower(levelName) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
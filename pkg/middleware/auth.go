@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/auth"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/response"
+)
+
+type contextKey string
+
+const (
+	userIDKey contextKey = "userID"
+	roleKey   contextKey = "role"
+)
+
+var errMissingCredentials = errors.New("missing credentials")
+
+// RequireAuth validates the caller's credentials and injects their user ID
+// and role into the request context. Credentials are read from an
+// `Authorization: Bearer <token>` header or, as an alternative flow, from an
+// `access_token` cookie — the cookie flow additionally requires an
+// `X-XSRF-Token` header matching the token's embedded XSRF value on any
+// request that isn't a GET or HEAD.
+func RequireAuth(manager *auth.Manager) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, err := authenticate(manager, r)
+			if err != nil {
+				response.Error(w, http.StatusUnauthorized, "Unauthorized")
+				return
+			}
+
+			userID, err := claims.UserID()
+			if err != nil {
+				response.Error(w, http.StatusUnauthorized, "Unauthorized")
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), userIDKey, userID)
+			ctx = context.WithValue(ctx, roleKey, claims.Role)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireAdmin rejects the request unless the authenticated caller has the
+// admin role. It must run after RequireAuth.
+func RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if RoleFromContext(r.Context()) != models.RoleAdmin {
+			response.Error(w, http.StatusForbidden, "Admin role required")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func authenticate(manager *auth.Manager, r *http.Request) (*auth.Claims, error) {
+	if header := r.Header.Get("Authorization"); header != "" {
+		token := strings.TrimPrefix(header, "Bearer ")
+		return manager.Parse(token)
+	}
+
+	cookie, err := r.Cookie("access_token")
+	if err != nil {
+		return nil, errMissingCredentials
+	}
+
+	claims, err := manager.Parse(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		if claims.XSRFToken == "" || claims.XSRFToken != r.Header.Get("X-XSRF-Token") {
+			return nil, errors.New("invalid or missing X-XSRF-Token")
+		}
+	}
+
+	return claims, nil
+}
+
+// UserIDFromContext returns the authenticated caller's user ID, as set by
+// RequireAuth.
+func UserIDFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(userIDKey).(int)
+	return id, ok
+}
+
+// RoleFromContext returns the authenticated caller's role, as set by
+// RequireAuth.
+func RoleFromContext(ctx context.Context) models.Role {
+	role, _ := ctx.Value(roleKey).(models.Role)
+	return role
+}
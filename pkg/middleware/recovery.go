@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/response"
+)
+
+// Recovery turns a panic in a handler into a 500 response instead of
+// crashing the server.
+func Recovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if err := recover(); err != nil {
+				log.Printf("panic handling %s %s: %v", r.Method, r.URL.Path, err)
+				response.Error(w, http.StatusInternalServerError, "Internal server error")
+			}
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
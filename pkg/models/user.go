@@ -0,0 +1,40 @@
+// Package models holds the domain types shared across the store and
+// handlers packages.
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// Role is a user's permission level.
+type Role string
+
+const (
+	RoleAdmin Role = "admin"
+	RoleUser  Role = "user"
+)
+
+// User represents a user in our system
+type User struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	Age          int       `json:"age"`
+	PasswordHash string    `json:"-"`
+	Role         Role      `json:"role"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ErrInvalidUser is returned by Validate when required fields are missing.
+var ErrInvalidUser = errors.New("name, email, and age are required")
+
+// Validate reports whether u has the fields required to be created or
+// updated.
+func (u User) Validate() error {
+	if u.Name == "" || u.Email == "" || u.Age <= 0 {
+		return ErrInvalidUser
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"io/fs"
+	"net/http"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/openapi/swaggerui"
+	"github.com/heinwaiyanhtet/test-mcp/pkg/response"
+)
+
+// JSONSpec serves the OpenAPI document as JSON.
+func JSONSpec(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, Build())
+}
+
+// YAMLSpec serves the OpenAPI document as YAML.
+func YAMLSpec(w http.ResponseWriter, r *http.Request) {
+	body, err := yaml.Marshal(Build())
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(body)
+}
+
+// docsFS is swaggerui.Dist rooted at its "dist" directory, so it serves
+// index.html, swagger-ui.css, etc. directly rather than under a "dist/"
+// prefix.
+var docsFS = func() fs.FS {
+	sub, err := fs.Sub(swaggerui.Dist, "dist")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}()
+
+// Docs serves the embedded Swagger UI pointed at JSONSpec. The assets are
+// vendored via go:embed rather than loaded from a CDN, so the docs page
+// keeps working in deployments without outbound network access.
+var Docs = http.StripPrefix("/api/v1/docs", http.FileServer(http.FS(docsFS)))
@@ -0,0 +1,249 @@
+// Package openapi builds an OpenAPI 3.0 document describing the users API
+// and serves it alongside a Swagger UI page. The document is the single
+// source of truth for request validation: see ValidateRequest.
+package openapi
+
+// schema is a minimal OpenAPI 3.0 Schema Object. It's a plain map rather
+// than a typed struct because OpenAPI schemas are recursive and only a
+// handful of keywords are needed here.
+type schema map[string]any
+
+var userSchema = schema{
+	"type": "object",
+	"properties": schema{
+		"id":         schema{"type": "integer"},
+		"name":       schema{"type": "string"},
+		"email":      schema{"type": "string", "format": "email"},
+		"age":        schema{"type": "integer", "minimum": 1},
+		"role":       schema{"type": "string", "enum": []string{"admin", "user"}},
+		"created_at": schema{"type": "string", "format": "date-time"},
+		"updated_at": schema{"type": "string", "format": "date-time"},
+	},
+	"required": []string{"name", "email", "age"},
+}
+
+var usersEnvelopeSchema = schema{
+	"type": "object",
+	"properties": schema{
+		"data":        schema{"type": "array", "items": schema{"$ref": "#/components/schemas/User"}},
+		"page":        schema{"type": "integer"},
+		"per_page":    schema{"type": "integer"},
+		"total":       schema{"type": "integer"},
+		"total_pages": schema{"type": "integer"},
+	},
+}
+
+var errorSchema = schema{
+	"type": "object",
+	"properties": schema{
+		"error": schema{"type": "string"},
+	},
+	"required": []string{"error"},
+}
+
+var credentialsSchema = schema{
+	"type": "object",
+	"properties": schema{
+		"name":     schema{"type": "string"},
+		"email":    schema{"type": "string", "format": "email"},
+		"age":      schema{"type": "integer", "minimum": 1},
+		"password": schema{"type": "string"},
+	},
+	"required": []string{"email", "password"},
+}
+
+var tokenResponseSchema = schema{
+	"type": "object",
+	"properties": schema{
+		"access_token":  schema{"type": "string"},
+		"refresh_token": schema{"type": "string"},
+		"token_type":    schema{"type": "string"},
+		"expires_in":    schema{"type": "integer"},
+		"xsrf_token":    schema{"type": "string"},
+	},
+}
+
+// plainResponses builds a {status: description} Responses Object for status
+// codes that don't need a documented body, e.g. a bare 200 or the error
+// codes shared by most operations.
+func plainResponses(codes ...string) schema {
+	responses := schema{}
+	for _, code := range codes {
+		if code[0] == '2' {
+			responses[code] = schema{
+				"description": "Success",
+				"content":     schema{"application/json": schema{"schema": schema{"type": "object"}}},
+			}
+			continue
+		}
+		responses[code] = schema{
+			"description": "Error",
+			"content": schema{
+				"application/json": schema{"schema": schema{"$ref": "#/components/schemas/Error"}},
+			},
+		}
+	}
+	return responses
+}
+
+func jsonBody(ref string) schema {
+	return schema{
+		"required": true,
+		"content": schema{
+			"application/json": schema{"schema": schema{"$ref": ref}},
+		},
+	}
+}
+
+func jsonResponse(description, ref string) schema {
+	return schema{
+		"description": description,
+		"content": schema{
+			"application/json": schema{"schema": schema{"$ref": ref}},
+		},
+	}
+}
+
+func idParam() schema {
+	return schema{
+		"name":     "id",
+		"in":       "path",
+		"required": true,
+		"schema":   schema{"type": "integer"},
+	}
+}
+
+// Build assembles the OpenAPI document describing every route registered in
+// handlers.Handlers.RegisterRoutes.
+func Build() schema {
+	return schema{
+		"openapi": "3.0.3",
+		"info": schema{
+			"title":   "test-mcp users API",
+			"version": "1.0.0",
+		},
+		"components": schema{
+			"securitySchemes": schema{
+				"BearerAuth": schema{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+			"schemas": schema{
+				"User":          userSchema,
+				"UsersEnvelope": usersEnvelopeSchema,
+				"Error":         errorSchema,
+				"Credentials":   credentialsSchema,
+				"TokenResponse": tokenResponseSchema,
+			},
+		},
+		"paths": schema{
+			"/api/v1/auth/register": schema{
+				"post": schema{
+					"summary":     "Register a new user",
+					"requestBody": jsonBody("#/components/schemas/Credentials"),
+					"responses": merge(
+						schema{"201": jsonResponse("Registered", "#/components/schemas/TokenResponse")},
+						plainResponses("400", "409"),
+					),
+				},
+			},
+			"/api/v1/auth/login": schema{
+				"post": schema{
+					"summary":     "Exchange credentials for tokens",
+					"requestBody": jsonBody("#/components/schemas/Credentials"),
+					"responses": merge(
+						schema{"201": jsonResponse("Authenticated", "#/components/schemas/TokenResponse")},
+						plainResponses("400", "401"),
+					),
+				},
+			},
+			"/api/v1/auth/refresh": schema{
+				"post": schema{
+					"summary": "Exchange a refresh token for a new access token",
+					"responses": merge(
+						schema{"201": jsonResponse("Refreshed", "#/components/schemas/TokenResponse")},
+						plainResponses("400", "401"),
+					),
+				},
+			},
+			"/api/v1/users": schema{
+				"post": schema{
+					"summary":     "Create a user (admin only)",
+					"security":    []schema{{"BearerAuth": []string{}}},
+					"requestBody": jsonBody("#/components/schemas/User"),
+					"responses": merge(
+						schema{"201": jsonResponse("Created", "#/components/schemas/User")},
+						plainResponses("400", "401", "403", "409"),
+					),
+				},
+				"get": schema{
+					"summary":  "List users, paginated and optionally filtered/sorted (admin only)",
+					"security": []schema{{"BearerAuth": []string{}}},
+					"parameters": []schema{
+						{"name": "page", "in": "query", "schema": schema{"type": "integer"}},
+						{"name": "per_page", "in": "query", "schema": schema{"type": "integer"}},
+						{"name": "sort", "in": "query", "schema": schema{"type": "string"}},
+						{"name": "order", "in": "query", "schema": schema{"type": "string"}},
+						{"name": "name", "in": "query", "schema": schema{"type": "string"}},
+						{"name": "email", "in": "query", "schema": schema{"type": "string"}},
+						{"name": "min_age", "in": "query", "schema": schema{"type": "integer"}},
+						{"name": "max_age", "in": "query", "schema": schema{"type": "integer"}},
+					},
+					"responses": merge(
+						schema{"200": jsonResponse("A page of users", "#/components/schemas/UsersEnvelope")},
+						plainResponses("400", "401", "403"),
+					),
+				},
+			},
+			"/api/v1/users/count": schema{
+				"get": schema{
+					"summary":   "Count users",
+					"security":  []schema{{"BearerAuth": []string{}}},
+					"responses": plainResponses("200", "401"),
+				},
+			},
+			"/api/v1/users/clear": schema{
+				"delete": schema{
+					"summary":   "Delete all users (admin only)",
+					"security":  []schema{{"BearerAuth": []string{}}},
+					"responses": plainResponses("200", "401", "403"),
+				},
+			},
+			"/api/v1/users/{id}": schema{
+				"get": schema{
+					"summary":    "Get a user by ID (self or admin)",
+					"security":   []schema{{"BearerAuth": []string{}}},
+					"parameters": []schema{idParam()},
+					"responses": merge(
+						schema{"200": jsonResponse("The user", "#/components/schemas/User")},
+						plainResponses("400", "401", "403", "404"),
+					),
+				},
+				"put": schema{
+					"summary":     "Update a user by ID (self or admin)",
+					"security":    []schema{{"BearerAuth": []string{}}},
+					"parameters":  []schema{idParam()},
+					"requestBody": jsonBody("#/components/schemas/User"),
+					"responses": merge(
+						schema{"200": jsonResponse("The updated user", "#/components/schemas/User")},
+						plainResponses("400", "401", "403", "404", "409"),
+					),
+				},
+				"delete": schema{
+					"summary":    "Delete a user by ID (admin only)",
+					"security":   []schema{{"BearerAuth": []string{}}},
+					"parameters": []schema{idParam()},
+					"responses":  plainResponses("200", "401", "403", "404"),
+				},
+			},
+		},
+	}
+}
+
+func merge(schemas ...schema) schema {
+	out := schema{}
+	for _, s := range schemas {
+		for k, v := range s {
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,26 @@
+// Package response provides consistent JSON response helpers so handlers
+// don't hand-roll http.Error/json.NewEncoder calls with inconsistent error
+// shapes.
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type errorBody struct {
+	Error string `json:"error"`
+}
+
+// JSON writes payload as a JSON response with the given status code.
+func JSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// Error writes a structured {"error": "..."} JSON response with the given
+// status code.
+func Error(w http.ResponseWriter, status int, message string) {
+	JSON(w, status, errorBody{Error: message})
+}
@@ -0,0 +1,223 @@
+package store
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+)
+
+// MemoryStore is an in-memory UserStore. It's the default backend and keeps
+// the original behaviour of the handlers: data does not survive a restart.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	users  []models.User
+	nextID int
+}
+
+// NewMemoryStore returns a MemoryStore seeded with the given users. IDs in
+// seed are respected and nextID is advanced past the highest one.
+func NewMemoryStore(seed ...models.User) *MemoryStore {
+	s := &MemoryStore{nextID: 1}
+	for _, u := range seed {
+		s.users = append(s.users, u)
+		if u.ID >= s.nextID {
+			s.nextID = u.ID + 1
+		}
+	}
+	return s
+}
+
+func (s *MemoryStore) findByID(id int) (*models.User, int) {
+	for i, u := range s.users {
+		if u.ID == id {
+			return &u, i
+		}
+	}
+	return nil, -1
+}
+
+func (s *MemoryStore) emailTaken(email string, excludeID int) bool {
+	for _, u := range s.users {
+		if u.Email == email && u.ID != excludeID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MemoryStore) CreateUser(user models.User) (models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.emailTaken(user.Email, -1) {
+		return models.User{}, ErrEmailConflict
+	}
+
+	if user.Role == "" {
+		user.Role = models.RoleUser
+	}
+
+	user.ID = s.nextID
+	s.nextID++
+	now := time.Now()
+	user.CreatedAt = now
+	user.UpdatedAt = now
+
+	s.users = append(s.users, user)
+	return user, nil
+}
+
+func (s *MemoryStore) GetUsers(query UserQuery) (UserPage, error) {
+	if err := query.Normalize(); err != nil {
+		return UserPage{}, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	filtered := make([]models.User, 0, len(s.users))
+	for _, u := range s.users {
+		if matchesQuery(u, query) {
+			filtered = append(filtered, u)
+		}
+	}
+
+	sortUsers(filtered, query.Sort, query.Order)
+
+	total := len(filtered)
+	start := query.Offset()
+	if start > total {
+		start = total
+	}
+	end := start + query.PerPage
+	if end > total {
+		end = total
+	}
+
+	page := make([]models.User, end-start)
+	copy(page, filtered[start:end])
+
+	return UserPage{Users: page, Total: total}, nil
+}
+
+func matchesQuery(u models.User, query UserQuery) bool {
+	if query.Name != "" && !strings.Contains(strings.ToLower(u.Name), strings.ToLower(query.Name)) {
+		return false
+	}
+	if query.Email != "" && !strings.Contains(strings.ToLower(u.Email), strings.ToLower(query.Email)) {
+		return false
+	}
+	if query.MinAge != 0 && u.Age < query.MinAge {
+		return false
+	}
+	if query.MaxAge != 0 && u.Age > query.MaxAge {
+		return false
+	}
+	return true
+}
+
+func sortUsers(users []models.User, field, order string) {
+	less := func(a, b models.User) bool {
+		switch field {
+		case "name":
+			return a.Name < b.Name
+		case "age":
+			return a.Age < b.Age
+		default:
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		if order == "desc" {
+			return less(users[j], users[i])
+		}
+		return less(users[i], users[j])
+	})
+}
+
+func (s *MemoryStore) GetUser(id int) (models.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, _ := s.findByID(id)
+	if user == nil {
+		return models.User{}, ErrUserNotFound
+	}
+	return *user, nil
+}
+
+func (s *MemoryStore) GetUserByEmail(email string) (models.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, u := range s.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return models.User{}, ErrUserNotFound
+}
+
+func (s *MemoryStore) UpdateUser(id int, updated models.User) (models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, index := s.findByID(id)
+	if user == nil {
+		return models.User{}, ErrUserNotFound
+	}
+
+	if s.emailTaken(updated.Email, id) {
+		return models.User{}, ErrEmailConflict
+	}
+
+	s.users[index].Name = updated.Name
+	s.users[index].Email = updated.Email
+	s.users[index].Age = updated.Age
+	if updated.PasswordHash != "" {
+		s.users[index].PasswordHash = updated.PasswordHash
+	}
+	if updated.Role != "" {
+		s.users[index].Role = updated.Role
+	}
+	s.users[index].UpdatedAt = time.Now()
+
+	return s.users[index], nil
+}
+
+func (s *MemoryStore) DeleteUser(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, index := s.findByID(id)
+	if index == -1 {
+		return ErrUserNotFound
+	}
+
+	s.users = append(s.users[:index], s.users[index+1:]...)
+	return nil
+}
+
+func (s *MemoryStore) GetUsersCount() (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.users), nil
+}
+
+func (s *MemoryStore) ClearUsers() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users = []models.User{}
+	s.nextID = 1
+	return nil
+}
+
+func (s *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,206 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+)
+
+const postgresSchema = `
+CREATE TABLE IF NOT EXISTS users (
+	id            SERIAL PRIMARY KEY,
+	name          TEXT NOT NULL,
+	email         TEXT NOT NULL UNIQUE,
+	age           INTEGER NOT NULL,
+	password_hash TEXT NOT NULL DEFAULT '',
+	role          TEXT NOT NULL DEFAULT 'user',
+	created_at    TIMESTAMPTZ NOT NULL,
+	updated_at    TIMESTAMPTZ NOT NULL
+);
+`
+
+// PostgresStore is a UserStore backed by a Postgres database.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore connects to the Postgres database described by dsn and
+// runs schema migrations.
+func NewPostgresStore(dsn string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	if _, err := db.Exec(postgresSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &PostgresStore{db: db}, nil
+}
+
+func (s *PostgresStore) CreateUser(user models.User) (models.User, error) {
+	if user.Role == "" {
+		user.Role = models.RoleUser
+	}
+
+	now := time.Now()
+	err := s.db.QueryRow(
+		`INSERT INTO users (name, email, age, password_hash, role, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+		user.Name, user.Email, user.Age, user.PasswordHash, user.Role, now, now,
+	).Scan(&user.ID)
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return models.User{}, ErrEmailConflict
+		}
+		return models.User{}, err
+	}
+
+	user.CreatedAt = now
+	user.UpdatedAt = now
+	return user, nil
+}
+
+func (s *PostgresStore) GetUsers(query UserQuery) (UserPage, error) {
+	if err := query.Normalize(); err != nil {
+		return UserPage{}, err
+	}
+
+	where, args, orderBy := buildWhereClause(query, func(n int) string { return fmt.Sprintf("$%d", n) })
+
+	var total int
+	countQuery := "SELECT COUNT(*) FROM users " + where
+	if err := s.db.QueryRow(countQuery, args...).Scan(&total); err != nil {
+		return UserPage{}, err
+	}
+
+	listArgs := append(append([]interface{}{}, args...), query.PerPage, query.Offset())
+	listQuery := fmt.Sprintf(
+		"SELECT id, name, email, age, password_hash, role, created_at, updated_at FROM users %s %s LIMIT $%d OFFSET $%d",
+		where, orderBy, len(args)+1, len(args)+2,
+	)
+	rows, err := s.db.Query(listQuery, listArgs...)
+	if err != nil {
+		return UserPage{}, err
+	}
+	defer rows.Close()
+
+	users := []models.User{}
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.PasswordHash, &u.Role, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			return UserPage{}, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return UserPage{}, err
+	}
+
+	return UserPage{Users: users, Total: total}, nil
+}
+
+func (s *PostgresStore) GetUser(id int) (models.User, error) {
+	var u models.User
+	err := s.db.QueryRow(
+		`SELECT id, name, email, age, password_hash, role, created_at, updated_at FROM users WHERE id = $1`, id,
+	).Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.PasswordHash, &u.Role, &u.CreatedAt, &u.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return models.User{}, err
+	}
+	return u, nil
+}
+
+func (s *PostgresStore) GetUserByEmail(email string) (models.User, error) {
+	var u models.User
+	err := s.db.QueryRow(
+		`SELECT id, name, email, age, password_hash, role, created_at, updated_at FROM users WHERE email = $1`, email,
+	).Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.PasswordHash, &u.Role, &u.CreatedAt, &u.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return models.User{}, err
+	}
+	return u, nil
+}
+
+func (s *PostgresStore) UpdateUser(id int, updated models.User) (models.User, error) {
+	now := time.Now()
+	res, err := s.db.Exec(
+		`UPDATE users SET name = $1, email = $2, age = $3,
+			password_hash = COALESCE(NULLIF($4, ''), password_hash),
+			role = COALESCE(NULLIF($5, ''), role),
+			updated_at = $6
+		 WHERE id = $7`,
+		updated.Name, updated.Email, updated.Age, updated.PasswordHash, updated.Role, now, id,
+	)
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return models.User{}, ErrEmailConflict
+		}
+		return models.User{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return models.User{}, err
+	}
+	if affected == 0 {
+		return models.User{}, ErrUserNotFound
+	}
+
+	return s.GetUser(id)
+}
+
+func (s *PostgresStore) DeleteUser(id int) error {
+	res, err := s.db.Exec(`DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetUsersCount() (int, error) {
+	var count int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&count)
+	return count, err
+}
+
+func (s *PostgresStore) ClearUsers() error {
+	_, err := s.db.Exec(`TRUNCATE TABLE users RESTART IDENTITY`)
+	return err
+}
+
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
+
+// isPgUniqueViolation reports whether err comes from a unique_violation
+// (SQLSTATE 23505). Checked by message to avoid importing lib/pq's error
+// type just for this.
+func isPgUniqueViolation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "23505")
+}
@@ -0,0 +1,124 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+)
+
+const (
+	DefaultPerPage = 20
+	MaxPerPage     = 100
+)
+
+// SortableFields whitelists the columns GetUsers may sort by, so a caller
+// can't inject arbitrary SQL through the sort query parameter.
+var SortableFields = map[string]bool{
+	"name":       true,
+	"age":        true,
+	"created_at": true,
+}
+
+// UserQuery describes the filtering, sorting, and pagination to apply to
+// GetUsers. It composes the same way against the in-memory store (a slice
+// filter) and a SQL store (a parameterized WHERE/ORDER BY/LIMIT/OFFSET).
+type UserQuery struct {
+	Page    int
+	PerPage int
+	Sort    string // one of SortableFields; defaults to "created_at"
+	Order   string // "asc" or "desc"; defaults to "asc"
+
+	Name   string // substring match
+	Email  string // substring match
+	MinAge int    // 0 means unset
+	MaxAge int    // 0 means unset
+}
+
+// UserPage is the result of a paginated GetUsers query.
+type UserPage struct {
+	Users []models.User
+	Total int
+}
+
+// Normalize fills in defaults and clamps out-of-range values. It returns an
+// error if Sort or Order name something handlers shouldn't accept.
+func (q *UserQuery) Normalize() error {
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.PerPage <= 0 {
+		q.PerPage = DefaultPerPage
+	}
+	if q.PerPage > MaxPerPage {
+		q.PerPage = MaxPerPage
+	}
+
+	if q.Sort == "" {
+		q.Sort = "created_at"
+	} else if !SortableFields[q.Sort] {
+		return fmt.Errorf("invalid sort field %q", q.Sort)
+	}
+
+	switch q.Order {
+	case "":
+		q.Order = "asc"
+	case "asc", "desc":
+	default:
+		return fmt.Errorf("invalid order %q (want asc or desc)", q.Order)
+	}
+
+	return nil
+}
+
+// Offset returns the SQL OFFSET for the query's page/per-page.
+func (q UserQuery) Offset() int {
+	return (q.Page - 1) * q.PerPage
+}
+
+// buildWhereClause builds the WHERE predicate and ORDER BY clause for q,
+// using placeholder(n) to render the n-th bind parameter (sqlite uses "?",
+// postgres uses "$n"). Sort is validated against SortableFields by
+// Normalize before this is called, so it's safe to interpolate directly.
+func buildWhereClause(q UserQuery, placeholder func(n int) string) (where string, args []interface{}, orderBy string) {
+	var conditions []string
+	n := 0
+
+	next := func() string {
+		n++
+		return placeholder(n)
+	}
+
+	if q.Name != "" {
+		conditions = append(conditions, "name LIKE "+next())
+		args = append(args, "%"+q.Name+"%")
+	}
+	if q.Email != "" {
+		conditions = append(conditions, "email LIKE "+next())
+		args = append(args, "%"+q.Email+"%")
+	}
+	if q.MinAge != 0 {
+		conditions = append(conditions, "age >= "+next())
+		args = append(args, q.MinAge)
+	}
+	if q.MaxAge != 0 {
+		conditions = append(conditions, "age <= "+next())
+		args = append(args, q.MaxAge)
+	}
+
+	if len(conditions) > 0 {
+		where = "WHERE " + joinAnd(conditions)
+	}
+
+	orderBy = fmt.Sprintf("ORDER BY %s %s", q.Sort, strings.ToUpper(q.Order))
+
+	return where, args, orderBy
+}
+
+func joinAnd(conditions []string) string {
+	out := conditions[0]
+	for _, c := range conditions[1:] {
+		out += " AND " + c
+	}
+	return out
+}
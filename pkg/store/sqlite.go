@@ -0,0 +1,215 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+)
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS users (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	name          TEXT NOT NULL,
+	email         TEXT NOT NULL UNIQUE,
+	age           INTEGER NOT NULL,
+	password_hash TEXT NOT NULL DEFAULT '',
+	role          TEXT NOT NULL DEFAULT 'user',
+	created_at    DATETIME NOT NULL,
+	updated_at    DATETIME NOT NULL
+);
+`
+
+// SQLiteStore is a UserStore backed by a SQLite database file.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if necessary) the SQLite database at dsn
+// and runs schema migrations.
+func NewSQLiteStore(dsn string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite only supports one writer at a time; a single connection avoids
+	// "database is locked" errors under concurrent requests.
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+func (s *SQLiteStore) CreateUser(user models.User) (models.User, error) {
+	if user.Role == "" {
+		user.Role = models.RoleUser
+	}
+
+	now := time.Now()
+	res, err := s.db.Exec(
+		`INSERT INTO users (name, email, age, password_hash, role, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		user.Name, user.Email, user.Age, user.PasswordHash, user.Role, now, now,
+	)
+	if err != nil {
+		if isUniqueConstraintErr(err) {
+			return models.User{}, ErrEmailConflict
+		}
+		return models.User{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return models.User{}, err
+	}
+
+	user.ID = int(id)
+	user.CreatedAt = now
+	user.UpdatedAt = now
+	return user, nil
+}
+
+func (s *SQLiteStore) GetUsers(query UserQuery) (UserPage, error) {
+	if err := query.Normalize(); err != nil {
+		return UserPage{}, err
+	}
+
+	where, args, orderBy := buildWhereClause(query, func(int) string { return "?" })
+
+	var total int
+	countQuery := "SELECT COUNT(*) FROM users " + where
+	if err := s.db.QueryRow(countQuery, args...).Scan(&total); err != nil {
+		return UserPage{}, err
+	}
+
+	listArgs := append(append([]interface{}{}, args...), query.PerPage, query.Offset())
+	listQuery := fmt.Sprintf(
+		"SELECT id, name, email, age, password_hash, role, created_at, updated_at FROM users %s %s LIMIT ? OFFSET ?",
+		where, orderBy,
+	)
+	rows, err := s.db.Query(listQuery, listArgs...)
+	if err != nil {
+		return UserPage{}, err
+	}
+	defer rows.Close()
+
+	users := []models.User{}
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.PasswordHash, &u.Role, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			return UserPage{}, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return UserPage{}, err
+	}
+
+	return UserPage{Users: users, Total: total}, nil
+}
+
+func (s *SQLiteStore) GetUser(id int) (models.User, error) {
+	var u models.User
+	err := s.db.QueryRow(
+		`SELECT id, name, email, age, password_hash, role, created_at, updated_at FROM users WHERE id = ?`, id,
+	).Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.PasswordHash, &u.Role, &u.CreatedAt, &u.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return models.User{}, err
+	}
+	return u, nil
+}
+
+func (s *SQLiteStore) GetUserByEmail(email string) (models.User, error) {
+	var u models.User
+	err := s.db.QueryRow(
+		`SELECT id, name, email, age, password_hash, role, created_at, updated_at FROM users WHERE email = ?`, email,
+	).Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.PasswordHash, &u.Role, &u.CreatedAt, &u.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return models.User{}, err
+	}
+	return u, nil
+}
+
+func (s *SQLiteStore) UpdateUser(id int, updated models.User) (models.User, error) {
+	now := time.Now()
+	res, err := s.db.Exec(
+		`UPDATE users SET name = ?, email = ?, age = ?,
+			password_hash = COALESCE(NULLIF(?, ''), password_hash),
+			role = COALESCE(NULLIF(?, ''), role),
+			updated_at = ?
+		 WHERE id = ?`,
+		updated.Name, updated.Email, updated.Age, updated.PasswordHash, updated.Role, now, id,
+	)
+	if err != nil {
+		if isUniqueConstraintErr(err) {
+			return models.User{}, ErrEmailConflict
+		}
+		return models.User{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return models.User{}, err
+	}
+	if affected == 0 {
+		return models.User{}, ErrUserNotFound
+	}
+
+	return s.GetUser(id)
+}
+
+func (s *SQLiteStore) DeleteUser(id int) error {
+	res, err := s.db.Exec(`DELETE FROM users WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (s *SQLiteStore) GetUsersCount() (int, error) {
+	var count int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&count)
+	return count, err
+}
+
+func (s *SQLiteStore) ClearUsers() error {
+	_, err := s.db.Exec(`DELETE FROM users`)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`DELETE FROM sqlite_sequence WHERE name = 'users'`)
+	return err
+}
+
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+// isUniqueConstraintErr reports whether err comes from a UNIQUE constraint
+// violation. Checked by message since go-sqlite3's typed error isn't worth
+// pulling in just for this.
+func isUniqueConstraintErr(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), "unique constraint")
+}
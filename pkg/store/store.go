@@ -0,0 +1,63 @@
+// Package store defines the persistence interface for users and the
+// concrete backends that implement it (in-memory, SQLite, Postgres).
+package store
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/heinwaiyanhtet/test-mcp/pkg/models"
+)
+
+// Sentinel errors returned by UserStore implementations so handlers can
+// translate them into the right HTTP status without depending on a
+// particular backend's error types.
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrEmailConflict = errors.New("email already exists")
+)
+
+// UserStore abstracts persistence for users so the HTTP layer doesn't care
+// whether data lives in memory, SQLite, or Postgres.
+type UserStore interface {
+	CreateUser(user models.User) (models.User, error)
+	GetUsers(query UserQuery) (UserPage, error)
+	GetUser(id int) (models.User, error)
+	GetUserByEmail(email string) (models.User, error)
+	UpdateUser(id int, user models.User) (models.User, error)
+	DeleteUser(id int) error
+	GetUsersCount() (int, error)
+	ClearUsers() error
+	Close() error
+}
+
+// sampleUsers seeds the in-memory store so the demo has data on first run.
+func sampleUsers() []models.User {
+	now := time.Now()
+	return []models.User{
+		{ID: 1, Name: "John Doe", Email: "john@example.com", Age: 30, Role: models.RoleAdmin, CreatedAt: now, UpdatedAt: now},
+		{ID: 2, Name: "Jane Smith", Email: "jane@example.com", Age: 25, Role: models.RoleUser, CreatedAt: now, UpdatedAt: now},
+	}
+}
+
+// New builds the UserStore selected by backend, connecting to dsn for the
+// sqlite/postgres backends.
+func New(backend, dsn string) (UserStore, error) {
+	switch backend {
+	case "memory":
+		return NewMemoryStore(sampleUsers()...), nil
+	case "sqlite":
+		if dsn == "" {
+			dsn = "users.db"
+		}
+		return NewSQLiteStore(dsn)
+	case "postgres":
+		if dsn == "" {
+			return nil, fmt.Errorf("a DSN is required for the postgres backend")
+		}
+		return NewPostgresStore(dsn)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q (want memory, sqlite, or postgres)", backend)
+	}
+}